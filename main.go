@@ -1,13 +1,14 @@
 package main
 
 import (
+	"context"
 	"dummyjson/product"
 	"fmt"
 	"net/http"
 )
 
 func main() {
-	svc := product.NewProductService("https://dummyjson.com/products/add", http.DefaultClient)
+	svc := product.NewProductService("https://dummyjson.com/products", http.DefaultClient, product.ServiceOptions{})
 
 	newProduct := product.Product{
 		Title:       "BMW Pencil 11",
@@ -17,7 +18,7 @@ func main() {
 		Category:    "stationery 15",
 	}
 
-	added, err := svc.AddProduct(newProduct)
+	added, err := svc.AddProduct(context.Background(), newProduct)
 	if err != nil {
 		fmt.Println("Failed to add product:", err)
 		return
@@ -0,0 +1,14 @@
+package product
+
+import "fmt"
+
+// StatusError is returned when an upstream response's status code isn't one
+// a service method expected, so callers (such as httpapi) can inspect the
+// original status instead of only seeing a formatted error string.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
@@ -0,0 +1,110 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"dummyjson/product"
+)
+
+// addProductHandler decodes a Product from the request body and creates it
+// through svc.
+func addProductHandler(svc product.ProductService) Handler {
+	return func(req *http.Request) JSONResponse {
+		var p product.Product
+		if err := json.NewDecoder(req.Body).Decode(&p); err != nil {
+			return ErrorResponse(http.StatusBadRequest, ErrCodeBadJSON, "Invalid JSON in request body")
+		}
+
+		added, err := svc.AddProduct(req.Context(), p)
+		if err != nil {
+			return mapError(err)
+		}
+
+		return JSONResponse{Code: http.StatusCreated, JSON: added}
+	}
+}
+
+// getProductHandler looks up the product named by the {id} path value.
+func getProductHandler(svc product.ProductService) Handler {
+	return func(req *http.Request) JSONResponse {
+		id, resp, ok := pathID(req)
+		if !ok {
+			return resp
+		}
+
+		found, err := svc.GetProduct(req.Context(), id)
+		if err != nil {
+			return mapError(err)
+		}
+
+		return JSONResponse{Code: http.StatusOK, JSON: found}
+	}
+}
+
+// updateProductHandler decodes a Product patch from the request body and
+// applies it to the product named by the {id} path value.
+func updateProductHandler(svc product.ProductService) Handler {
+	return func(req *http.Request) JSONResponse {
+		id, resp, ok := pathID(req)
+		if !ok {
+			return resp
+		}
+
+		var patch product.Product
+		if err := json.NewDecoder(req.Body).Decode(&patch); err != nil {
+			return ErrorResponse(http.StatusBadRequest, ErrCodeBadJSON, "Invalid JSON in request body")
+		}
+
+		updated, err := svc.UpdateProduct(req.Context(), id, patch)
+		if err != nil {
+			return mapError(err)
+		}
+
+		return JSONResponse{Code: http.StatusOK, JSON: updated}
+	}
+}
+
+// deleteProductHandler deletes the product named by the {id} path value.
+func deleteProductHandler(svc product.ProductService) Handler {
+	return func(req *http.Request) JSONResponse {
+		id, resp, ok := pathID(req)
+		if !ok {
+			return resp
+		}
+
+		deleted, err := svc.DeleteProduct(req.Context(), id)
+		if err != nil {
+			return mapError(err)
+		}
+
+		return JSONResponse{Code: http.StatusOK, JSON: deleted}
+	}
+}
+
+// pathID extracts and parses the {id} path value from req. When it isn't a
+// valid integer, ok is false and resp is the response the caller should
+// return instead.
+func pathID(req *http.Request) (id int, resp JSONResponse, ok bool) {
+	id, err := strconv.Atoi(mux.Vars(req)["id"])
+	if err != nil {
+		return 0, ErrorResponse(http.StatusBadRequest, ErrCodeBadJSON, "id must be an integer"), false
+	}
+	return id, JSONResponse{}, true
+}
+
+// mapError translates an error returned by product.ProductService into a
+// JSONResponse, passing a *product.StatusError's upstream 404 through as
+// ErrCodeNotFound and falling back to a generic bad-gateway response
+// otherwise.
+func mapError(err error) JSONResponse {
+	var statusErr *product.StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return ErrorResponse(http.StatusNotFound, ErrCodeNotFound, "Product not found")
+	}
+	return ErrorResponse(http.StatusBadGateway, ErrCodeUnknown, err.Error())
+}
@@ -0,0 +1,306 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"dummyjson/product"
+	"dummyjson/product/httpapi"
+	"dummyjson/product/internal/testinstance"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddProduct(t *testing.T) {
+	tests := []struct {
+		description string
+		reqBody     product.Product
+		mockResp    *http.Response
+		mockErr     error
+		wantCode    int
+		wantBody    string
+	}{
+		{
+			description: "successful product creation",
+			reqBody: product.Product{
+				Title:       "Test Product",
+				Description: "This is a test product",
+				Price:       1999,
+				Brand:       "Test Brand",
+				Category:    "Test Category",
+			},
+			mockResp: &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"id":1,"title":"Test Product","description":"This is a test product","price":1999,"brand":"Test Brand","category":"Test Category"}`)),
+			},
+			wantCode: http.StatusCreated,
+			wantBody: `{"id":1,"title":"Test Product","description":"This is a test product","price":1999,"brand":"Test Brand","category":"Test Category"}`,
+		},
+		{
+			description: "upstream network error",
+			reqBody:     product.Product{Title: "Broken"},
+			mockErr:     errors.New("network error"),
+			wantCode:    http.StatusBadGateway,
+			wantBody:    `{"errcode":"M_UNKNOWN","error":"failed to send request: Post \"http://upstream.test/products/add\": network error"}`,
+		},
+		{
+			description: "upstream 500",
+			reqBody:     product.Product{Title: "Broken"},
+			mockResp: &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Body:       io.NopCloser(strings.NewReader("")),
+			},
+			wantCode: http.StatusBadGateway,
+			wantBody: `{"errcode":"M_UNKNOWN","error":"unexpected status code: 500"}`,
+		},
+		{
+			description: "upstream response body is not valid JSON",
+			reqBody:     product.Product{Title: "Broken"},
+			mockResp: &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader("not json")),
+			},
+			wantCode: http.StatusBadGateway,
+			wantBody: `{"errcode":"M_UNKNOWN","error":"failed to decode response: invalid character 'o' in literal null (expecting 'u')"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ti := testinstance.New(t, "http://upstream.test/products")
+
+			ti.MockClient.EXPECT().
+				RoundTrip(gomock.Any()).
+				Return(tc.mockResp, tc.mockErr).
+				Times(1)
+
+			reqBody, err := json.Marshal(tc.reqBody)
+			assert.NoError(t, err)
+
+			resp, err := http.Post(ti.Server.URL+"/products", "application/json", bytes.NewReader(reqBody))
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.wantCode, resp.StatusCode)
+
+			gotBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.wantBody, string(gotBody))
+		})
+	}
+}
+
+func TestGetProduct(t *testing.T) {
+	tests := []struct {
+		description string
+		mockResp    *http.Response
+		mockErr     error
+		wantCode    int
+		wantBody    string
+	}{
+		{
+			description: "found",
+			mockResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"id":1,"title":"Test Product"}`)),
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"id":1,"title":"Test Product","description":"","price":0,"brand":"","category":""}`,
+		},
+		{
+			description: "upstream 404 passes through as not found",
+			mockResp: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: `{"errcode":"M_NOT_FOUND","error":"Product not found"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ti := testinstance.New(t, "http://upstream.test/products")
+
+			ti.MockClient.EXPECT().
+				RoundTrip(gomock.Any()).
+				Return(tc.mockResp, tc.mockErr).
+				Times(1)
+
+			resp, err := http.Get(ti.Server.URL + "/products/1")
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.wantCode, resp.StatusCode)
+
+			gotBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.wantBody, string(gotBody))
+		})
+	}
+}
+
+func TestUpdateProduct(t *testing.T) {
+	tests := []struct {
+		description string
+		reqBody     string
+		mockResp    *http.Response
+		mockErr     error
+		mockTimes   int
+		wantCode    int
+		wantBody    string
+	}{
+		{
+			description: "updated",
+			reqBody:     `{"title":"Updated Product"}`,
+			mockResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"id":1,"title":"Updated Product"}`)),
+			},
+			mockTimes: 1,
+			wantCode:  http.StatusOK,
+			wantBody:  `{"id":1,"title":"Updated Product","description":"","price":0,"brand":"","category":""}`,
+		},
+		{
+			description: "upstream 404 passes through as not found",
+			reqBody:     `{"title":"Updated Product"}`,
+			mockResp: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			},
+			mockTimes: 1,
+			wantCode:  http.StatusNotFound,
+			wantBody:  `{"errcode":"M_NOT_FOUND","error":"Product not found"}`,
+		},
+		{
+			description: "request body is not valid JSON",
+			reqBody:     "not json",
+			mockTimes:   0,
+			wantCode:    http.StatusBadRequest,
+			wantBody:    `{"errcode":"M_BAD_JSON","error":"Invalid JSON in request body"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ti := testinstance.New(t, "http://upstream.test/products")
+
+			ti.MockClient.EXPECT().
+				RoundTrip(gomock.Any()).
+				Return(tc.mockResp, tc.mockErr).
+				Times(tc.mockTimes)
+
+			req, err := http.NewRequest(http.MethodPut, ti.Server.URL+"/products/1", strings.NewReader(tc.reqBody))
+			assert.NoError(t, err)
+
+			resp, err := ti.Server.Client().Do(req)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.wantCode, resp.StatusCode)
+
+			gotBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.wantBody, string(gotBody))
+		})
+	}
+}
+
+func TestDeleteProduct(t *testing.T) {
+	tests := []struct {
+		description string
+		mockResp    *http.Response
+		mockErr     error
+		wantCode    int
+		wantBody    string
+	}{
+		{
+			description: "deleted",
+			mockResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"id":1,"title":"Test Product"}`)),
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"id":1,"title":"Test Product","description":"","price":0,"brand":"","category":""}`,
+		},
+		{
+			description: "upstream 404 passes through as not found",
+			mockResp: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: `{"errcode":"M_NOT_FOUND","error":"Product not found"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ti := testinstance.New(t, "http://upstream.test/products")
+
+			ti.MockClient.EXPECT().
+				RoundTrip(gomock.Any()).
+				Return(tc.mockResp, tc.mockErr).
+				Times(1)
+
+			req, err := http.NewRequest(http.MethodDelete, ti.Server.URL+"/products/1", nil)
+			assert.NoError(t, err)
+
+			resp, err := ti.Server.Client().Do(req)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.wantCode, resp.StatusCode)
+
+			gotBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.wantBody, string(gotBody))
+		})
+	}
+}
+
+func TestProtect(t *testing.T) {
+	t.Run("sets X-Request-Id on the response", func(t *testing.T) {
+		ti := testinstance.New(t, "http://upstream.test/products")
+
+		ti.MockClient.EXPECT().
+			RoundTrip(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil).
+			Times(1)
+
+		resp, err := http.Get(ti.Server.URL + "/products/1")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.NotEmpty(t, resp.Header.Get("X-Request-Id"))
+	})
+
+	t.Run("recovers a handler panic into a 500 JSON response", func(t *testing.T) {
+		handler := httpapi.Protect(func(req *http.Request) httpapi.JSONResponse {
+			panic("boom")
+		})
+
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+
+		resp, err := http.Get(server.URL)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		gotBody, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"errcode":"M_UNKNOWN","error":"Internal server error"}`, string(gotBody))
+	})
+}
@@ -0,0 +1,37 @@
+package httpapi
+
+import (
+	"log"
+	"net/http"
+
+	"dummyjson/product"
+)
+
+// Handler is implemented by every httpapi endpoint. It returns the response
+// to write instead of writing to the ResponseWriter directly, so Protect can
+// apply consistent error handling and logging around it.
+type Handler func(req *http.Request) JSONResponse
+
+// Protect wraps h so that every inbound request gets a unique request ID and
+// a logger tagged with it, installed on the request's context via
+// product.WithLogger so outbound calls the handler triggers are logged under
+// the same ID. A panic during handling is recovered, logged, and turned into
+// a 500 JSON error response instead of crashing the server.
+func Protect(h Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id := newRequestID()
+		logger := log.New(log.Writer(), "["+id+"] ", log.LstdFlags)
+
+		w.Header().Set(RequestIDHeader, id)
+		req = req.WithContext(product.WithLogger(req.Context(), logger))
+
+		defer func() {
+			if rec := recover(); rec != nil {
+				logger.Printf("panic handling %s %s: %v", req.Method, req.URL.Path, rec)
+				ErrorResponse(http.StatusInternalServerError, ErrCodeUnknown, "Internal server error").Write(w)
+			}
+		}()
+
+		h(req).Write(w)
+	}
+}
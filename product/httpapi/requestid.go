@@ -0,0 +1,20 @@
+package httpapi
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDHeader is the response header carrying the unique ID assigned to
+// each inbound request, so it can be correlated with the logs it produced.
+const RequestIDHeader = "X-Request-Id"
+
+// newRequestID returns a short random hex string suitable for correlating a
+// request's logs across the inbound handler and any outbound calls it makes.
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
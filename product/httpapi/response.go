@@ -0,0 +1,58 @@
+// Package httpapi exposes the product service behind an HTTP server.
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Standard error codes returned in the "errcode" field of an error envelope.
+const (
+	ErrCodeUnknown        = "M_UNKNOWN"
+	ErrCodeBadJSON        = "M_BAD_JSON"
+	ErrCodeNotFound       = "M_NOT_FOUND"
+	ErrCodeNotImplemented = "M_NOT_IMPLEMENTED"
+)
+
+// JSONResponse is the value every Handler returns. It carries the HTTP
+// status code alongside the body to serialise, so handlers never write
+// directly to the ResponseWriter themselves.
+type JSONResponse struct {
+	Code int
+	JSON interface{}
+}
+
+// errorBody is the stable JSON shape emitted for every error response,
+// regardless of what upstream failure produced it.
+type errorBody struct {
+	ErrCode string `json:"errcode"`
+	Error   string `json:"error"`
+}
+
+// MessageResponse builds a JSONResponse with a plain {"message": ...} body.
+func MessageResponse(code int, msg string) JSONResponse {
+	return JSONResponse{
+		Code: code,
+		JSON: map[string]string{"message": msg},
+	}
+}
+
+// ErrorResponse builds a JSONResponse with the stable error envelope
+// {"errcode": ..., "error": ...}.
+func ErrorResponse(code int, errcode, msg string) JSONResponse {
+	return JSONResponse{
+		Code: code,
+		JSON: errorBody{
+			ErrCode: errcode,
+			Error:   msg,
+		},
+	}
+}
+
+// Write serialises the response to w, setting the JSON content type and
+// status code first.
+func (r JSONResponse) Write(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.Code)
+	_ = json.NewEncoder(w).Encode(r.JSON)
+}
@@ -0,0 +1,21 @@
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"dummyjson/product"
+)
+
+// NewServeMux builds the HTTP routing for the product API, backed by svc.
+func NewServeMux(svc product.ProductService) http.Handler {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/products", Protect(addProductHandler(svc))).Methods(http.MethodPost)
+	router.HandleFunc("/products/{id}", Protect(getProductHandler(svc))).Methods(http.MethodGet)
+	router.HandleFunc("/products/{id}", Protect(updateProductHandler(svc))).Methods(http.MethodPut)
+	router.HandleFunc("/products/{id}", Protect(deleteProductHandler(svc))).Methods(http.MethodDelete)
+
+	return router
+}
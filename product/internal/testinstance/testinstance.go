@@ -0,0 +1,47 @@
+// Package testinstance builds the shared fixtures httpapi endpoint tests
+// need: a mocked upstream transport, the real service wired to it, and an
+// httptest server exposing the HTTP API in front of that service.
+package testinstance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"dummyjson/product"
+	"dummyjson/product/httpapi"
+	"dummyjson/product/mocks"
+
+	"github.com/golang/mock/gomock"
+)
+
+// TestInstance bundles a gomock controller, the mocked RoundTripper that
+// stands in for the dummyjson upstream, the productService under test, and
+// an httptest server fronting it with the httpapi routes.
+type TestInstance struct {
+	Ctrl       *gomock.Controller
+	MockClient *mocks.MockRoundTripper
+	Svc        product.ProductService
+	Server     *httptest.Server
+}
+
+// New builds a TestInstance whose outbound HTTP calls are served by
+// MockClient instead of a real network connection. The server and
+// controller are torn down automatically via t.Cleanup.
+func New(t *testing.T, apiURL string) *TestInstance {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockRoundTripper(ctrl)
+
+	svc := product.NewProductService(apiURL, &http.Client{Transport: mockClient}, product.ServiceOptions{})
+	server := httptest.NewServer(httpapi.NewServeMux(svc))
+	t.Cleanup(server.Close)
+
+	return &TestInstance{
+		Ctrl:       ctrl,
+		MockClient: mockClient,
+		Svc:        svc,
+		Server:     server,
+	}
+}
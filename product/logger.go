@@ -0,0 +1,30 @@
+package product
+
+import (
+	"context"
+	"log"
+)
+
+// Logger is the logging interface productService calls out to. *log.Logger
+// satisfies it, so the standard library logger can be used as-is.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type loggerContextKey struct{}
+
+// WithLogger returns a copy of ctx carrying l as the per-request logger,
+// retrievable with LoggerFromContext. httpapi uses this to thread its
+// request-scoped logger into the outbound calls a handler triggers.
+func WithLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, l)
+}
+
+// LoggerFromContext returns the logger stored in ctx by WithLogger, or
+// log.Default() if none was set.
+func LoggerFromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(loggerContextKey{}).(Logger); ok && l != nil {
+		return l
+	}
+	return log.Default()
+}
@@ -1,9 +1,83 @@
 package product
 
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Dimensions describes the physical dimensions of a product, in centimetres.
+type Dimensions struct {
+	Width  float64 `json:"width,omitempty"`
+	Height float64 `json:"height,omitempty"`
+	Depth  float64 `json:"depth,omitempty"`
+}
+
+// Meta holds the bookkeeping fields dummyjson attaches to every product.
+type Meta struct {
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	Barcode   string `json:"barcode,omitempty"`
+	QRCode    string `json:"qrCode,omitempty"`
+}
+
+// Product is a dummyjson product, as sent to /products/add and returned by
+// the read endpoints.
 type Product struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Price       int    `json:"price"`
-	Brand       string `json:"brand"`
-	Category    string `json:"category"`
+	ID                 int         `json:"id,omitempty"`
+	Title              string      `json:"title"`
+	Description        string      `json:"description"`
+	Price              int         `json:"price"`
+	Brand              string      `json:"brand"`
+	Category           string      `json:"category"`
+	DiscountPercentage float64     `json:"discountPercentage,omitempty"`
+	Rating             float64     `json:"rating,omitempty"`
+	Stock              int         `json:"stock,omitempty"`
+	Tags               []string    `json:"tags,omitempty"`
+	SKU                string      `json:"sku,omitempty"`
+	Weight             float64     `json:"weight,omitempty"`
+	Dimensions         *Dimensions `json:"dimensions,omitempty"`
+	Images             []string    `json:"images,omitempty"`
+	Thumbnail          string      `json:"thumbnail,omitempty"`
+	Meta               *Meta       `json:"meta,omitempty"`
+}
+
+// ListOptions controls pagination, field selection and ordering for the
+// listing endpoints (ListProducts, SearchProducts, ProductsByCategory).
+type ListOptions struct {
+	Limit  int
+	Skip   int
+	Select []string
+	SortBy string
+	Order  string // "asc" or "desc"
+}
+
+// queryValues renders o as the query parameters dummyjson's listing
+// endpoints expect.
+func (o ListOptions) queryValues() url.Values {
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Skip > 0 {
+		v.Set("skip", strconv.Itoa(o.Skip))
+	}
+	if len(o.Select) > 0 {
+		v.Set("select", strings.Join(o.Select, ","))
+	}
+	if o.SortBy != "" {
+		v.Set("sortBy", o.SortBy)
+	}
+	if o.Order != "" {
+		v.Set("order", o.Order)
+	}
+	return v
+}
+
+// ProductList is the paginated response returned by the listing endpoints.
+type ProductList struct {
+	Products []Product `json:"products"`
+	Total    int       `json:"total"`
+	Skip     int       `json:"skip"`
+	Limit    int       `json:"limit"`
 }
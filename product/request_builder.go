@@ -0,0 +1,74 @@
+package product
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// BasicAuth holds a username/password pair for HTTP Basic Authentication.
+type BasicAuth [2]string
+
+// RequestBuilder builds outgoing *http.Request values with a consistent set
+// of auth and headers applied, so every service method constructs its
+// requests the same way.
+type RequestBuilder struct {
+	auth    *BasicAuth
+	token   string
+	headers http.Header
+	marshal MarshalFunc
+}
+
+// NewRequestBuilder creates a RequestBuilder configured from opts. marshal is
+// used to serialize request payloads and defaults to DefaultMarshal when nil.
+func NewRequestBuilder(opts ServiceOptions, marshal MarshalFunc) *RequestBuilder {
+	if marshal == nil {
+		marshal = DefaultMarshal
+	}
+	return &RequestBuilder{
+		auth:    opts.Auth,
+		token:   opts.Token,
+		headers: opts.Headers,
+		marshal: marshal,
+	}
+}
+
+// NewRequest builds an *http.Request for method and url, scoped to ctx so
+// that cancelling ctx (or its deadline expiring) aborts the in-flight
+// request. When payload is non-nil it is marshaled as the JSON request body
+// and a matching Content-Type header is set.
+func (b *RequestBuilder) NewRequest(ctx context.Context, method, url string, payload interface{}) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		data, err := b.marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, values := range b.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+
+	switch {
+	case b.auth != nil:
+		req.SetBasicAuth(b.auth[0], b.auth[1])
+	case b.token != "":
+		req.Header.Set("Authorization", "Bearer "+b.token)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,97 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestBuilder_NewRequest(t *testing.T) {
+	t.Run("sets basic auth when configured", func(t *testing.T) {
+		b := NewRequestBuilder(ServiceOptions{Auth: &BasicAuth{"user", "pass"}}, DefaultMarshal)
+
+		req, err := b.NewRequest(context.Background(), http.MethodGet, "http://example.com", nil)
+
+		assert.NoError(t, err)
+		user, pass, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "pass", pass)
+	})
+
+	t.Run("sets bearer token when configured", func(t *testing.T) {
+		b := NewRequestBuilder(ServiceOptions{Token: "abc123"}, DefaultMarshal)
+
+		req, err := b.NewRequest(context.Background(), http.MethodGet, "http://example.com", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer abc123", req.Header.Get("Authorization"))
+	})
+
+	t.Run("basic auth takes precedence over a token", func(t *testing.T) {
+		b := NewRequestBuilder(ServiceOptions{Auth: &BasicAuth{"user", "pass"}, Token: "abc123"}, DefaultMarshal)
+
+		req, err := b.NewRequest(context.Background(), http.MethodGet, "http://example.com", nil)
+
+		assert.NoError(t, err)
+		user, pass, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "pass", pass)
+	})
+
+	t.Run("applies custom headers", func(t *testing.T) {
+		headers := http.Header{"X-Custom": []string{"one", "two"}}
+		b := NewRequestBuilder(ServiceOptions{Headers: headers}, DefaultMarshal)
+
+		req, err := b.NewRequest(context.Background(), http.MethodGet, "http://example.com", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"one", "two"}, req.Header.Values("X-Custom"))
+	})
+
+	t.Run("marshals a non-nil payload and sets Content-Type", func(t *testing.T) {
+		b := NewRequestBuilder(ServiceOptions{}, DefaultMarshal)
+
+		req, err := b.NewRequest(context.Background(), http.MethodPost, "http://example.com", Product{Title: "x"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"title":"x"`)
+	})
+
+	t.Run("does not set Content-Type for a nil payload", func(t *testing.T) {
+		b := NewRequestBuilder(ServiceOptions{}, DefaultMarshal)
+
+		req, err := b.NewRequest(context.Background(), http.MethodGet, "http://example.com", nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, req.Header.Get("Content-Type"))
+	})
+
+	t.Run("wraps a marshal error", func(t *testing.T) {
+		b := NewRequestBuilder(ServiceOptions{}, func(v interface{}) ([]byte, error) {
+			return nil, errors.New("marshal error")
+		})
+
+		req, err := b.NewRequest(context.Background(), http.MethodPost, "http://example.com", Product{})
+
+		assert.Nil(t, req)
+		assert.ErrorContains(t, err, "failed to marshal payload")
+	})
+
+	t.Run("wraps a request creation error", func(t *testing.T) {
+		b := NewRequestBuilder(ServiceOptions{}, DefaultMarshal)
+
+		req, err := b.NewRequest(context.Background(), http.MethodGet, "http://\n", nil)
+
+		assert.Nil(t, req)
+		assert.ErrorContains(t, err, "failed to create request")
+	})
+}
@@ -0,0 +1,72 @@
+package product
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how a service retries a failed request.
+type RetryPolicy struct {
+	MaxAttempts int           // total attempts, including the first; <= 1 disables retries
+	Backoff     time.Duration // base delay before the first retry
+}
+
+// shouldRetry reports whether resp (with accompanying err, if any) warrants
+// another attempt: network errors and 5xx responses are retried, everything
+// else is returned to the caller as-is.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryDelay returns how long to wait before the given retry attempt
+// (1-indexed), using exponential backoff with full jitter. A Retry-After
+// header on resp, if present and parseable, takes precedence.
+func retryDelay(base time.Duration, attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	max := base << uint(attempt-1)
+	if max <= 0 {
+		max = base
+	}
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// sleepCtx waits for d, returning early with ctx.Err() if ctx is done first.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryAfter parses a Retry-After header expressed as a number of seconds.
+// The HTTP-date form is not supported.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	secs, err := strconv.Atoi(header)
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
@@ -0,0 +1,123 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		description string
+		resp        *http.Response
+		err         error
+		want        bool
+	}{
+		{
+			description: "network error always retries",
+			err:         errors.New("network error"),
+			want:        true,
+		},
+		{
+			description: "5xx retries",
+			resp:        &http.Response{StatusCode: http.StatusServiceUnavailable},
+			want:        true,
+		},
+		{
+			description: "2xx does not retry",
+			resp:        &http.Response{StatusCode: http.StatusOK},
+			want:        false,
+		},
+		{
+			description: "4xx does not retry",
+			resp:        &http.Response{StatusCode: http.StatusNotFound},
+			want:        false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.want, shouldRetry(tc.resp, tc.err))
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Run("Retry-After header takes precedence over backoff", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+
+		delay := retryDelay(time.Second, 1, resp)
+
+		assert.Equal(t, 2*time.Second, delay)
+	})
+
+	t.Run("falls back to jittered exponential backoff", func(t *testing.T) {
+		base := 10 * time.Millisecond
+
+		for attempt := 1; attempt <= 3; attempt++ {
+			delay := retryDelay(base, attempt, nil)
+
+			max := base << uint(attempt-1)
+			assert.GreaterOrEqual(t, delay, time.Duration(0))
+			assert.LessOrEqual(t, delay, max)
+		}
+	})
+
+	t.Run("ignores an unparseable Retry-After header", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"not-a-number"}}}
+
+		delay := retryDelay(10*time.Millisecond, 1, resp)
+
+		assert.LessOrEqual(t, delay, 10*time.Millisecond)
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		description string
+		header      string
+		wantOK      bool
+		wantDelay   time.Duration
+	}{
+		{description: "empty header", header: "", wantOK: false},
+		{description: "valid seconds", header: "5", wantOK: true, wantDelay: 5 * time.Second},
+		{description: "negative seconds rejected", header: "-1", wantOK: false},
+		{description: "non-numeric value rejected", header: "Wed, 21 Oct 2026 07:28:00 GMT", wantOK: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			delay, ok := retryAfter(tc.header)
+
+			assert.Equal(t, tc.wantOK, ok)
+			if tc.wantOK {
+				assert.Equal(t, tc.wantDelay, delay)
+			}
+		})
+	}
+}
+
+func TestSleepCtx(t *testing.T) {
+	t.Run("zero delay returns immediately", func(t *testing.T) {
+		assert.NoError(t, sleepCtx(context.Background(), 0))
+	})
+
+	t.Run("returns ctx.Err when ctx is already done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := sleepCtx(ctx, time.Second)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("waits out the delay when ctx is not cancelled", func(t *testing.T) {
+		err := sleepCtx(context.Background(), 10*time.Millisecond)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,17 @@
+package product
+
+import "context"
+
+// ProductService is the full set of operations this module exposes against
+// the dummyjson products API.
+type ProductService interface {
+	AddProduct(ctx context.Context, p Product) (Product, error)
+	GetProduct(ctx context.Context, id int) (Product, error)
+	UpdateProduct(ctx context.Context, id int, patch Product) (Product, error)
+	DeleteProduct(ctx context.Context, id int) (Product, error)
+	ListProducts(ctx context.Context, opts ListOptions) (ProductList, error)
+	SearchProducts(ctx context.Context, query string, opts ListOptions) (ProductList, error)
+	ProductsByCategory(ctx context.Context, category string, opts ListOptions) (ProductList, error)
+}
+
+var _ ProductService = (*productService)(nil)
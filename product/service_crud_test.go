@@ -0,0 +1,219 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// queuedRoundTripper implements http.RoundTripper, returning its responses in
+// order on successive calls, one per RoundTrip.
+type queuedRoundTripper struct {
+	responses []*http.Response
+	calls     int
+}
+
+func (q *queuedRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	resp := q.responses[q.calls]
+	q.calls++
+	return resp, nil
+}
+
+func jsonResponse(code int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+	}
+}
+
+func TestGetProduct(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/1", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(Product{ID: 1, Title: "Widget"})
+		}))
+		defer server.Close()
+
+		svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+		got, err := svc.GetProduct(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.ID)
+		assert.Equal(t, "Widget", got.Title)
+	})
+
+	t.Run("upstream 404 is returned as a StatusError", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+		_, err := svc.GetProduct(context.Background(), 1)
+
+		var statusErr *StatusError
+		assert.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+	})
+}
+
+func TestUpdateProduct(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(Product{ID: 1, Title: "Updated"})
+	}))
+	defer server.Close()
+
+	svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+	got, err := svc.UpdateProduct(context.Background(), 1, Product{Title: "Updated"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Updated", got.Title)
+}
+
+func TestDeleteProduct(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(Product{ID: 1})
+	}))
+	defer server.Close()
+
+	svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+	got, err := svc.DeleteProduct(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.ID)
+}
+
+func TestListProducts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(ProductList{Products: []Product{{ID: 1}}, Total: 1, Limit: 10})
+	}))
+	defer server.Close()
+
+	svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+	got, err := svc.ListProducts(context.Background(), ListOptions{Limit: 10})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.Total)
+	assert.Len(t, got.Products, 1)
+}
+
+func TestListProducts_UpstreamError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+	_, err := svc.ListProducts(context.Background(), ListOptions{})
+
+	var statusErr *StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+}
+
+func TestSearchProducts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "phone", r.URL.Query().Get("q"))
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(ProductList{})
+	}))
+	defer server.Close()
+
+	svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+	_, err := svc.SearchProducts(context.Background(), "phone", ListOptions{})
+
+	assert.NoError(t, err)
+}
+
+func TestProductsByCategory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/category/smart phones", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(ProductList{})
+	}))
+	defer server.Close()
+
+	svc := NewProductService(server.URL, server.Client(), ServiceOptions{})
+
+	_, err := svc.ProductsByCategory(context.Background(), "smart phones", ListOptions{})
+
+	assert.NoError(t, err)
+}
+
+func TestDo_RetriesOn5xxThenSucceeds(t *testing.T) {
+	transport := &queuedRoundTripper{responses: []*http.Response{
+		jsonResponse(http.StatusServiceUnavailable, ""),
+		jsonResponse(http.StatusOK, `{"id":1,"title":"Widget"}`),
+	}}
+
+	svc := NewProductService("http://example.com/products", &http.Client{Transport: transport}, ServiceOptions{
+		RetryPolicy: RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond},
+	})
+
+	got, err := svc.GetProduct(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Widget", got.Title)
+	assert.Equal(t, 2, transport.calls)
+}
+
+func TestDo_HonoursRetryAfterHeader(t *testing.T) {
+	retryResp := jsonResponse(http.StatusServiceUnavailable, "")
+	retryResp.Header.Set("Retry-After", "0")
+
+	transport := &queuedRoundTripper{responses: []*http.Response{
+		retryResp,
+		jsonResponse(http.StatusOK, `{"id":1}`),
+	}}
+
+	svc := NewProductService("http://example.com/products", &http.Client{Transport: transport}, ServiceOptions{
+		RetryPolicy: RetryPolicy{MaxAttempts: 2, Backoff: time.Hour},
+	})
+
+	start := time.Now()
+	_, err := svc.GetProduct(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestDo_ExhaustsRetriesAndReturnsStatusError(t *testing.T) {
+	transport := &queuedRoundTripper{responses: []*http.Response{
+		jsonResponse(http.StatusServiceUnavailable, ""),
+		jsonResponse(http.StatusServiceUnavailable, ""),
+	}}
+
+	svc := NewProductService("http://example.com/products", &http.Client{Transport: transport}, ServiceOptions{
+		RetryPolicy: RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond},
+	})
+
+	_, err := svc.GetProduct(context.Background(), 1)
+
+	var statusErr *StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	assert.Equal(t, 2, transport.calls)
+}
@@ -1,10 +1,12 @@
 package product
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 )
 
 // MarshalFunc is a function type for JSON marshaling
@@ -13,41 +15,108 @@ type MarshalFunc func(v interface{}) ([]byte, error)
 // Default marshal function that uses the standard json.Marshal
 var DefaultMarshal MarshalFunc = json.Marshal
 
+// ServiceOptions configures a productService beyond its base URL and HTTP
+// client: authentication, default headers, retry behaviour and timeout.
+type ServiceOptions struct {
+	Auth        *BasicAuth
+	Token       string
+	Headers     http.Header
+	RetryPolicy RetryPolicy
+	Timeout     time.Duration
+}
+
 type productService struct {
 	apiURL  string
 	client  *http.Client
 	marshal MarshalFunc // Custom marshal function for testing
+	builder *RequestBuilder
+	retry   RetryPolicy
 }
 
-// NewProductService creates a new productService with default configuration
-func NewProductService(apiURL string, client *http.Client) *productService {
+// NewProductService creates a new productService with default configuration,
+// overridden by the values set on opts. apiURL is the base products
+// endpoint, e.g. "https://dummyjson.com/products"; individual operations
+// append their own paths (/add, /{id}, /search, ...) to it.
+func NewProductService(apiURL string, client *http.Client, opts ServiceOptions) *productService {
+	if client == nil {
+		client = &http.Client{}
+	}
+	if opts.Timeout > 0 {
+		c := *client
+		c.Timeout = opts.Timeout
+		client = &c
+	}
+
 	return &productService{
 		apiURL:  apiURL,
 		client:  client,
 		marshal: DefaultMarshal,
+		builder: NewRequestBuilder(opts, DefaultMarshal),
+		retry:   opts.RetryPolicy,
 	}
 }
 
-func (s *productService) AddProduct(p Product) (Product, error) {
-	body, err := json.Marshal(p)
+func (s *productService) AddProduct(ctx context.Context, p Product) (Product, error) {
+	req, err := s.builder.NewRequest(ctx, http.MethodPost, s.apiURL+"/add", p)
 	if err != nil {
-		return Product{}, fmt.Errorf("failed to marshal product: %w", err)
+		return Product{}, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, s.apiURL, bytes.NewBuffer(body))
+	return s.doProduct(ctx, req, http.StatusOK, http.StatusCreated)
+}
+
+func (s *productService) GetProduct(ctx context.Context, id int) (Product, error) {
+	req, err := s.builder.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d", s.apiURL, id), nil)
 	if err != nil {
-		return Product{}, fmt.Errorf("failed to create request: %w", err)
+		return Product{}, err
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := s.client.Do(req)
+	return s.doProduct(ctx, req, http.StatusOK)
+}
+
+func (s *productService) UpdateProduct(ctx context.Context, id int, patch Product) (Product, error) {
+	req, err := s.builder.NewRequest(ctx, http.MethodPut, fmt.Sprintf("%s/%d", s.apiURL, id), patch)
 	if err != nil {
-		return Product{}, fmt.Errorf("failed to send request: %w", err)
+		return Product{}, err
+	}
+
+	return s.doProduct(ctx, req, http.StatusOK)
+}
+
+func (s *productService) DeleteProduct(ctx context.Context, id int) (Product, error) {
+	req, err := s.builder.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", s.apiURL, id), nil)
+	if err != nil {
+		return Product{}, err
+	}
+
+	return s.doProduct(ctx, req, http.StatusOK)
+}
+
+func (s *productService) ListProducts(ctx context.Context, opts ListOptions) (ProductList, error) {
+	return s.doList(ctx, s.apiURL, opts.queryValues())
+}
+
+func (s *productService) SearchProducts(ctx context.Context, query string, opts ListOptions) (ProductList, error) {
+	values := opts.queryValues()
+	values.Set("q", query)
+	return s.doList(ctx, s.apiURL+"/search", values)
+}
+
+func (s *productService) ProductsByCategory(ctx context.Context, category string, opts ListOptions) (ProductList, error) {
+	return s.doList(ctx, s.apiURL+"/category/"+url.PathEscape(category), opts.queryValues())
+}
+
+// doProduct sends req and decodes a single Product from the response body,
+// treating any status code other than those in wantCodes as an error.
+func (s *productService) doProduct(ctx context.Context, req *http.Request, wantCodes ...int) (Product, error) {
+	resp, err := s.do(ctx, req)
+	if err != nil {
+		return Product{}, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return Product{}, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	if !statusIn(resp.StatusCode, wantCodes) {
+		return Product{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	var result Product
@@ -57,3 +126,107 @@ func (s *productService) AddProduct(p Product) (Product, error) {
 
 	return result, nil
 }
+
+// doList sends a GET request to endpoint with values as its query string and
+// decodes a ProductList from the response body.
+func (s *productService) doList(ctx context.Context, endpoint string, values url.Values) (ProductList, error) {
+	if len(values) > 0 {
+		endpoint += "?" + values.Encode()
+	}
+
+	req, err := s.builder.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return ProductList{}, err
+	}
+
+	resp, err := s.do(ctx, req)
+	if err != nil {
+		return ProductList{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ProductList{}, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	var result ProductList
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return ProductList{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+func statusIn(code int, codes []int) bool {
+	for _, c := range codes {
+		if code == c {
+			return true
+		}
+	}
+	return false
+}
+
+// do sends req, retrying on network errors and 5xx responses according to
+// s.retry, with exponential backoff honouring a Retry-After header when the
+// server sends one. Every attempt is logged with the per-request logger
+// pulled from ctx, recording method, URL, latency, status and attempt
+// number.
+func (s *productService) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	logger := LoggerFromContext(ctx)
+
+	attempts := s.retry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		start := time.Now()
+		resp, err := s.client.Do(req)
+		latency := time.Since(start)
+
+		if err != nil {
+			logger.Printf("product: %s %s attempt=%d/%d latency=%s error=%v", req.Method, req.URL, attempt, attempts, latency, err)
+		} else {
+			logger.Printf("product: %s %s attempt=%d/%d latency=%s status=%d", req.Method, req.URL, attempt, attempts, latency, resp.StatusCode)
+		}
+
+		if !shouldRetry(resp, err) {
+			if err != nil {
+				return nil, fmt.Errorf("failed to send request: %w", err)
+			}
+			return resp, nil
+		}
+
+		if err != nil {
+			lastErr = fmt.Errorf("failed to send request: %w", err)
+		} else {
+			lastErr = &StatusError{StatusCode: resp.StatusCode}
+		}
+
+		if attempt == attempts {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			break
+		}
+
+		delay := retryDelay(s.retry.Backoff, attempt, resp)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleepCtx(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
@@ -1,20 +1,20 @@
 package product_test
 
 import (
-	"dummyjson/product"
+	"context"
 	"errors"
 	"testing"
 
+	"dummyjson/product"
+	"dummyjson/product/mocks"
+
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestAddProductSuccess(t *testing.T) {
-	mock := &product.MockProductService{
-		AddProductFunc: func(p product.Product) (product.Product, error) {
-			p.Title = "Mocked Product"
-			return p, nil
-		},
-	}
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockProductService(ctrl)
 
 	input := product.Product{
 		Title:       "Test",
@@ -23,21 +23,24 @@ func TestAddProductSuccess(t *testing.T) {
 		Brand:       "Brand",
 		Category:    "Category",
 	}
+	want := input
+	want.Title = "Mocked Product"
 
-	output, err := mock.AddProduct(input)
+	mock.EXPECT().AddProduct(context.Background(), input).Return(want, nil)
+
+	output, err := mock.AddProduct(context.Background(), input)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Mocked Product", output.Title)
 }
 
 func TestAddProductFailure(t *testing.T) {
-	mock := &product.MockProductService{
-		AddProductFunc: func(p product.Product) (product.Product, error) {
-			return product.Product{}, errors.New("mock failure")
-		},
-	}
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockProductService(ctrl)
+
+	mock.EXPECT().AddProduct(context.Background(), product.Product{}).Return(product.Product{}, errors.New("mock failure"))
 
-	_, err := mock.AddProduct(product.Product{})
+	_, err := mock.AddProduct(context.Background(), product.Product{})
 	assert.Error(t, err)
 	assert.EqualError(t, err, "mock failure")
 }